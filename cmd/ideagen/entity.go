@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Field describes one attribute of a generated entity.
+type Field struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	JSON    string `json:"json"`
+	Binding string `json:"binding"`
+}
+
+// Entity is the definition consumed by the generator, typically loaded
+// from a JSON file such as:
+//
+//	{
+//	  "name": "Tag",
+//	  "fields": [
+//	    {"name": "Name", "type": "string", "json": "name", "binding": "required"},
+//	    {"name": "Slug", "type": "string", "json": "slug"}
+//	  ]
+//	}
+type Entity struct {
+	Name   string  `json:"name"`
+	Fields []Field `json:"fields"`
+}
+
+// LoadEntity reads and validates an entity definition file.
+func LoadEntity(path string) (*Entity, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read entity file: %w", err)
+	}
+
+	var entity Entity
+	if err := json.Unmarshal(data, &entity); err != nil {
+		return nil, fmt.Errorf("parse entity file: %w", err)
+	}
+
+	if entity.Name == "" {
+		return nil, fmt.Errorf("entity definition is missing a name")
+	}
+	if len(entity.Fields) == 0 {
+		return nil, fmt.Errorf("entity %q has no fields", entity.Name)
+	}
+
+	return &entity, nil
+}
+
+// Lower returns the entity name with a lowercase first letter, e.g. "tag".
+func (e Entity) Lower() string {
+	if e.Name == "" {
+		return e.Name
+	}
+	return strings.ToLower(e.Name[:1]) + e.Name[1:]
+}
+
+// Plural returns a naive plural of the entity name, e.g. "Tags".
+func (e Entity) Plural() string {
+	return e.Name + "s"
+}
+
+// LowerPlural returns the lowercase plural, e.g. "tags".
+func (e Entity) LowerPlural() string {
+	return e.Lower() + "s"
+}
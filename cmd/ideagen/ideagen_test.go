@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerateLabelProducesValidGo runs the generator against the shipped
+// label.entity.json example and checks the generated files parse as valid Go
+// and follow the same conventions as the rest of the codebase (ErrorResponse
+// rather than ad-hoc gin.H error bodies).
+func TestGenerateLabelProducesValidGo(t *testing.T) {
+	entity, err := LoadEntity("examples/label.entity.json")
+	if err != nil {
+		t.Fatalf("load entity: %v", err)
+	}
+
+	outDir := t.TempDir()
+	if err := generate(outDir, entity); err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+
+	for tmplName, pathFormat := range outputs {
+		outPath := filepath.Join(outDir, fmt.Sprintf(pathFormat, entity.Lower()))
+
+		src, err := os.ReadFile(outPath)
+		if err != nil {
+			t.Fatalf("%s: read generated file: %v", tmplName, err)
+		}
+
+		if _, err := parser.ParseFile(token.NewFileSet(), outPath, src, 0); err != nil {
+			t.Errorf("%s: generated file is not valid Go: %v", tmplName, err)
+		}
+
+		if tmplName == "handler.go.tmpl" && strings.Contains(string(src), "gin.H{\"error\"") {
+			t.Errorf("%s: still emits ad-hoc gin.H errors instead of ErrorResponse", tmplName)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"github.com/mitermitek/Idea-Box-API/internal/repository"
+	"github.com/mitermitek/Idea-Box-API/internal/service"
+	httptransport "github.com/mitermitek/Idea-Box-API/internal/transport/http"
+
+	_ "github.com/mitermitek/Idea-Box-API/docs"
+)
+
+//go:generate swag init -g main.go -o ../../docs --parseDependency --parseInternal
+
+// @title Idea-Box API
+// @version 1.0
+// @description Boxes, ideas, and tags, with JWT-authenticated per-user ownership.
+// @BasePath /
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+func main() {
+	db, err := gorm.Open(sqlite.Open("idea-box.db?_pragma=foreign_keys(1)"), &gorm.Config{})
+	if err != nil {
+		panic(fmt.Sprintf("failed to connect database: %v", err))
+	}
+
+	db.AutoMigrate(&domain.User{}, &domain.Box{}, &domain.Idea{}, &domain.Tag{})
+	if err := repository.EnsureFTS(db); err != nil {
+		panic(fmt.Sprintf("failed to set up full-text search: %v", err))
+	}
+
+	userRepo := repository.NewUserRepository(db)
+	boxRepo := repository.NewBoxRepository(db)
+	ideaRepo := repository.NewIdeaRepository(db)
+	tagRepo := repository.NewTagRepository(db)
+
+	authService := service.NewAuthService(userRepo, jwtSecret())
+	boxService := service.NewBoxService(db, boxRepo)
+	ideaService := service.NewIdeaService(db, boxRepo, ideaRepo, tagRepo)
+	tagService := service.NewTagService(tagRepo)
+
+	router := gin.Default()
+	httptransport.RegisterRoutes(router.Group("/"), authService, boxService, ideaService, tagService)
+
+	router.Run(":8080")
+}
+
+func jwtSecret() []byte {
+	if secret := os.Getenv("JWT_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+	return []byte("dev-secret-change-me")
+}
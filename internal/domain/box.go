@@ -0,0 +1,28 @@
+package domain
+
+import "gorm.io/gorm"
+
+// Box is a collection of Ideas, owned by a single User.
+type Box struct {
+	ID          uint
+	Title       string
+	Description string
+	OwnerID     uint
+	Owner       User
+	Ideas       []Idea `gorm:"constraint:OnDelete:CASCADE;"`
+}
+
+// AfterCreate keeps the box_fts shadow table in sync for full-text search.
+func (b *Box) AfterCreate(tx *gorm.DB) error {
+	return tx.Exec(`INSERT INTO box_fts(rowid, title, description) VALUES (?, ?, ?)`, b.ID, b.Title, b.Description).Error
+}
+
+// AfterUpdate keeps the box_fts shadow table in sync for full-text search.
+func (b *Box) AfterUpdate(tx *gorm.DB) error {
+	return tx.Exec(`UPDATE box_fts SET title = ?, description = ? WHERE rowid = ?`, b.Title, b.Description, b.ID).Error
+}
+
+// AfterDelete keeps the box_fts shadow table in sync for full-text search.
+func (b *Box) AfterDelete(tx *gorm.DB) error {
+	return tx.Exec(`DELETE FROM box_fts WHERE rowid = ?`, b.ID).Error
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "gorm.io/gorm"
+
+// Idea belongs to a Box and is owned by the same User as that Box.
+type Idea struct {
+	ID          uint
+	Title       string
+	Description string
+	BoxID       uint
+	Box         Box
+	OwnerID     uint
+	Owner       User
+	Tags        []Tag `gorm:"many2many:idea_tags;"`
+}
+
+// AfterCreate keeps the idea_fts shadow table in sync for full-text search.
+func (i *Idea) AfterCreate(tx *gorm.DB) error {
+	return tx.Exec(`INSERT INTO idea_fts(rowid, title, description) VALUES (?, ?, ?)`, i.ID, i.Title, i.Description).Error
+}
+
+// AfterUpdate keeps the idea_fts shadow table in sync for full-text search.
+func (i *Idea) AfterUpdate(tx *gorm.DB) error {
+	return tx.Exec(`UPDATE idea_fts SET title = ?, description = ? WHERE rowid = ?`, i.Title, i.Description, i.ID).Error
+}
+
+// AfterDelete keeps the idea_fts shadow table in sync for full-text search.
+func (i *Idea) AfterDelete(tx *gorm.DB) error {
+	return tx.Exec(`DELETE FROM idea_fts WHERE rowid = ?`, i.ID).Error
+}
@@ -0,0 +1,9 @@
+package domain
+
+// Tag is a label that can be attached to any number of Ideas.
+type Tag struct {
+	ID    uint
+	Name  string
+	Slug  string `gorm:"uniqueIndex"`
+	Ideas []Idea `gorm:"many2many:idea_tags;"`
+}
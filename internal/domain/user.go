@@ -0,0 +1,17 @@
+package domain
+
+// Role distinguishes regular users from administrators.
+type Role string
+
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
+// User is an account that owns Boxes and Ideas.
+type User struct {
+	ID           uint
+	Email        string `gorm:"uniqueIndex"`
+	PasswordHash string
+	Role         Role
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"gorm.io/gorm"
+)
+
+// BoxRepository is the data-access contract for Box persistence.
+type BoxRepository interface {
+	FindAll(params ListParams) ([]domain.Box, int64, error)
+	FindAllByOwner(ownerID uint, params ListParams) ([]domain.Box, int64, error)
+	FindByID(id string) (*domain.Box, error)
+	Create(box *domain.Box) error
+	Update(box *domain.Box) error
+	Delete(box *domain.Box) error
+	// WithTx returns a BoxRepository that runs its queries against tx
+	// instead of the repository's own connection, so callers can compose
+	// it into a larger db.Transaction.
+	WithTx(tx *gorm.DB) BoxRepository
+}
+
+type boxRepository struct {
+	db *gorm.DB
+}
+
+// NewBoxRepository builds a GORM-backed BoxRepository.
+func NewBoxRepository(db *gorm.DB) BoxRepository {
+	return &boxRepository{db: db}
+}
+
+func (r *boxRepository) scope(params ListParams) *gorm.DB {
+	query := r.db.Model(&domain.Box{})
+	if params.Query != "" {
+		query = query.Joins("JOIN box_fts ON box_fts.rowid = boxes.id").Where("box_fts MATCH ?", params.Query)
+	}
+	return query
+}
+
+func (r *boxRepository) FindAll(params ListParams) ([]domain.Box, int64, error) {
+	return r.list(r.scope(params), params)
+}
+
+func (r *boxRepository) FindAllByOwner(ownerID uint, params ListParams) ([]domain.Box, int64, error) {
+	return r.list(r.scope(params).Where("boxes.owner_id = ?", ownerID), params)
+}
+
+func (r *boxRepository) list(query *gorm.DB, params ListParams) ([]domain.Box, int64, error) {
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var boxes []domain.Box
+	order := fmt.Sprintf("boxes.%s %s", params.SortColumn, params.SortOrder)
+	result := query.Preload("Ideas.Tags").Order(order).Limit(params.Limit).Offset(params.Offset).Find(&boxes)
+	return boxes, total, result.Error
+}
+
+func (r *boxRepository) FindByID(id string) (*domain.Box, error) {
+	var box domain.Box
+	if err := r.db.Preload("Ideas.Tags").First(&box, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &box, nil
+}
+
+func (r *boxRepository) Create(box *domain.Box) error {
+	return r.db.Create(box).Error
+}
+
+func (r *boxRepository) Update(box *domain.Box) error {
+	return r.db.Model(box).Updates(domain.Box{
+		Title:       box.Title,
+		Description: box.Description,
+	}).Error
+}
+
+// Delete removes box. Its ideas cascade via the OnDelete:CASCADE foreign
+// key at the SQLite level, so domain.Idea's AfterDelete hook never runs for
+// them; purge their idea_fts rows and idea_tags associations explicitly
+// before the cascade fires, since the latter has no cascade of its own and
+// would otherwise block the delete with a foreign key violation.
+func (r *boxRepository) Delete(box *domain.Box) error {
+	if err := r.db.Exec(`DELETE FROM idea_tags WHERE idea_id IN (SELECT id FROM ideas WHERE box_id = ?)`, box.ID).Error; err != nil {
+		return err
+	}
+	if err := r.db.Exec(`DELETE FROM idea_fts WHERE rowid IN (SELECT id FROM ideas WHERE box_id = ?)`, box.ID).Error; err != nil {
+		return err
+	}
+	return r.db.Delete(box).Error
+}
+
+func (r *boxRepository) WithTx(tx *gorm.DB) BoxRepository {
+	return &boxRepository{db: tx}
+}
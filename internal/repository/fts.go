@@ -0,0 +1,15 @@
+package repository
+
+import "gorm.io/gorm"
+
+// EnsureFTS creates the FTS5 shadow tables used for full-text search over
+// Box and Idea. It is safe to call on every startup.
+func EnsureFTS(db *gorm.DB) error {
+	if err := db.Exec(`CREATE VIRTUAL TABLE IF NOT EXISTS box_fts USING fts5(title, description)`).Error; err != nil {
+		return err
+	}
+	if err := db.Exec(`CREATE VIRTUAL TABLE IF NOT EXISTS idea_fts USING fts5(title, description)`).Error; err != nil {
+		return err
+	}
+	return nil
+}
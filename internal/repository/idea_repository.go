@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"gorm.io/gorm"
+)
+
+// IdeaRepository is the data-access contract for Idea persistence.
+type IdeaRepository interface {
+	FindByBoxID(boxID string, params ListParams) ([]domain.Idea, int64, error)
+	FindByID(boxID, ideaID string) (*domain.Idea, error)
+	Create(idea *domain.Idea) error
+	Update(idea *domain.Idea) error
+	Delete(idea *domain.Idea) error
+	AttachTags(idea *domain.Idea, tags []domain.Tag) error
+	DetachTag(idea *domain.Idea, tag *domain.Tag) error
+	// WithTx returns an IdeaRepository that runs its queries against tx
+	// instead of the repository's own connection, so callers can compose
+	// it into a larger db.Transaction.
+	WithTx(tx *gorm.DB) IdeaRepository
+}
+
+type ideaRepository struct {
+	db *gorm.DB
+}
+
+// NewIdeaRepository builds a GORM-backed IdeaRepository.
+func NewIdeaRepository(db *gorm.DB) IdeaRepository {
+	return &ideaRepository{db: db}
+}
+
+func (r *ideaRepository) FindByBoxID(boxID string, params ListParams) ([]domain.Idea, int64, error) {
+	query := r.db.Model(&domain.Idea{}).Where("ideas.box_id = ?", boxID)
+	if params.Query != "" {
+		query = query.Joins("JOIN idea_fts ON idea_fts.rowid = ideas.id").Where("idea_fts MATCH ?", params.Query)
+	}
+	if len(params.TagSlugs) > 0 {
+		query = query.Where("ideas.id IN (?)", r.db.Table("idea_tags").
+			Select("idea_tags.idea_id").
+			Joins("JOIN tags ON tags.id = idea_tags.tag_id").
+			Where("tags.slug IN ?", params.TagSlugs))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var ideas []domain.Idea
+	order := fmt.Sprintf("ideas.%s %s", params.SortColumn, params.SortOrder)
+	result := query.Preload("Tags").Order(order).Limit(params.Limit).Offset(params.Offset).Find(&ideas)
+	return ideas, total, result.Error
+}
+
+func (r *ideaRepository) FindByID(boxID, ideaID string) (*domain.Idea, error) {
+	var idea domain.Idea
+	if err := r.db.Preload("Tags").Where("id = ? AND box_id = ?", ideaID, boxID).First(&idea).Error; err != nil {
+		return nil, err
+	}
+	return &idea, nil
+}
+
+func (r *ideaRepository) Create(idea *domain.Idea) error {
+	return r.db.Create(idea).Error
+}
+
+func (r *ideaRepository) Update(idea *domain.Idea) error {
+	return r.db.Model(idea).Updates(domain.Idea{
+		Title:       idea.Title,
+		Description: idea.Description,
+	}).Error
+}
+
+// Delete removes idea. Its idea_tags rows have no cascade at the SQLite
+// level, so they must be cleared explicitly or the delete fails with a
+// foreign key violation whenever the idea has any tags attached.
+func (r *ideaRepository) Delete(idea *domain.Idea) error {
+	if err := r.db.Model(idea).Association("Tags").Clear(); err != nil {
+		return err
+	}
+	return r.db.Delete(idea).Error
+}
+
+func (r *ideaRepository) AttachTags(idea *domain.Idea, tags []domain.Tag) error {
+	return r.db.Model(idea).Association("Tags").Append(tags)
+}
+
+func (r *ideaRepository) DetachTag(idea *domain.Idea, tag *domain.Tag) error {
+	return r.db.Model(idea).Association("Tags").Delete(tag)
+}
+
+func (r *ideaRepository) WithTx(tx *gorm.DB) IdeaRepository {
+	return &ideaRepository{db: tx}
+}
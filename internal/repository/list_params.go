@@ -0,0 +1,14 @@
+package repository
+
+// ListParams captures the paging, sorting, and full-text search options
+// shared by every list endpoint.
+type ListParams struct {
+	Limit      int
+	Offset     int
+	SortColumn string
+	SortOrder  string
+	Query      string
+	// TagSlugs, when non-empty, restricts an Idea listing to ideas tagged
+	// with at least one of the given slugs.
+	TagSlugs []string
+}
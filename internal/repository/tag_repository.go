@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"gorm.io/gorm"
+)
+
+// TagRepository is the data-access contract for Tag persistence.
+type TagRepository interface {
+	FindAll() ([]domain.Tag, error)
+	FindByID(id string) (*domain.Tag, error)
+	FindBySlugs(slugs []string) ([]domain.Tag, error)
+	FindByIDs(ids []uint) ([]domain.Tag, error)
+	Create(tag *domain.Tag) error
+	Update(tag *domain.Tag) error
+	Delete(tag *domain.Tag) error
+	// WithTx returns a TagRepository that runs its queries against tx
+	// instead of the repository's own connection, so callers can compose
+	// it into a larger db.Transaction.
+	WithTx(tx *gorm.DB) TagRepository
+}
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+// NewTagRepository builds a GORM-backed TagRepository.
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return &tagRepository{db: db}
+}
+
+func (r *tagRepository) FindAll() ([]domain.Tag, error) {
+	var tags []domain.Tag
+	result := r.db.Find(&tags)
+	return tags, result.Error
+}
+
+func (r *tagRepository) FindByID(id string) (*domain.Tag, error) {
+	var tag domain.Tag
+	if err := r.db.First(&tag, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+func (r *tagRepository) FindBySlugs(slugs []string) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	result := r.db.Where("slug IN ?", slugs).Find(&tags)
+	return tags, result.Error
+}
+
+func (r *tagRepository) FindByIDs(ids []uint) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	result := r.db.Where("id IN ?", ids).Find(&tags)
+	return tags, result.Error
+}
+
+func (r *tagRepository) Create(tag *domain.Tag) error {
+	return r.db.Create(tag).Error
+}
+
+func (r *tagRepository) Update(tag *domain.Tag) error {
+	return r.db.Model(tag).Updates(domain.Tag{
+		Name: tag.Name,
+		Slug: tag.Slug,
+	}).Error
+}
+
+func (r *tagRepository) Delete(tag *domain.Tag) error {
+	if err := r.db.Model(tag).Association("Ideas").Clear(); err != nil {
+		return err
+	}
+	return r.db.Delete(tag).Error
+}
+
+func (r *tagRepository) WithTx(tx *gorm.DB) TagRepository {
+	return &tagRepository{db: tx}
+}
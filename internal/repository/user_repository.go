@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"gorm.io/gorm"
+)
+
+// UserRepository is the data-access contract for User persistence.
+type UserRepository interface {
+	FindByEmail(email string) (*domain.User, error)
+	FindByID(id uint) (*domain.User, error)
+	Create(user *domain.User) error
+	Count() (int64, error)
+}
+
+type userRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRepository builds a GORM-backed UserRepository.
+func NewUserRepository(db *gorm.DB) UserRepository {
+	return &userRepository{db: db}
+}
+
+func (r *userRepository) FindByEmail(email string) (*domain.User, error) {
+	var user domain.User
+	if err := r.db.First(&user, "email = ?", email).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) FindByID(id uint) (*domain.User, error) {
+	var user domain.User
+	if err := r.db.First(&user, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) Create(user *domain.User) error {
+	return r.db.Create(user).Error
+}
+
+func (r *userRepository) Count() (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.User{}).Count(&count).Error
+	return count, err
+}
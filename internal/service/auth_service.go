@@ -0,0 +1,118 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"github.com/mitermitek/Idea-Box-API/internal/repository"
+)
+
+// ErrInvalidCredentials is returned when a login's email/password don't match.
+var ErrInvalidCredentials = errors.New("invalid email or password")
+
+// ErrEmailTaken is returned when registering with an email that already exists.
+var ErrEmailTaken = errors.New("email already registered")
+
+const tokenTTL = 24 * time.Hour
+
+// Claims is the JWT payload used to authenticate requests.
+type Claims struct {
+	UserID uint        `json:"userId"`
+	Role   domain.Role `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// AuthService handles registration, login, and JWT verification.
+type AuthService interface {
+	Register(email, password string) (*domain.User, error)
+	Login(email, password string) (string, error)
+	Verify(token string) (*Claims, error)
+}
+
+type authService struct {
+	users  repository.UserRepository
+	secret []byte
+}
+
+// NewAuthService builds an AuthService signing tokens with secret.
+func NewAuthService(users repository.UserRepository, secret []byte) AuthService {
+	return &authService{users: users, secret: secret}
+}
+
+func (s *authService) Register(email, password string) (*domain.User, error) {
+	email = normalizeEmail(email)
+	if _, err := s.users.FindByEmail(email); err == nil {
+		return nil, ErrEmailTaken
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	// The very first account to register bootstraps the admin role, since
+	// there is otherwise no account capable of reaching /admin/*.
+	role := domain.RoleUser
+	count, err := s.users.Count()
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		role = domain.RoleAdmin
+	}
+
+	user := &domain.User{
+		Email:        email,
+		PasswordHash: string(hash),
+		Role:         role,
+	}
+	if err := s.users.Create(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *authService) Login(email, password string) (string, error) {
+	user, err := s.users.FindByEmail(normalizeEmail(email))
+	if err != nil {
+		return "", ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return "", ErrInvalidCredentials
+	}
+
+	claims := Claims{
+		UserID: user.ID,
+		Role:   user.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(tokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(s.secret)
+}
+
+// normalizeEmail lowercases email so registration and login treat
+// "Foo@Example.com" and "foo@example.com" as the same address.
+func normalizeEmail(email string) string {
+	return strings.ToLower(email)
+}
+
+func (s *authService) Verify(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		return s.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+	return claims, nil
+}
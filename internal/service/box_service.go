@@ -0,0 +1,88 @@
+package service
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"github.com/mitermitek/Idea-Box-API/internal/repository"
+)
+
+// ErrForbidden is returned when a user tries to access a Box or Idea they don't own.
+var ErrForbidden = errors.New("forbidden")
+
+// BoxService holds the business logic for Boxes.
+type BoxService interface {
+	List(ownerID uint, params repository.ListParams) ([]domain.Box, int64, error)
+	ListAll(params repository.ListParams) ([]domain.Box, int64, error)
+	Get(id string, ownerID uint, isAdmin bool) (*domain.Box, error)
+	Create(ownerID uint, title, description string) (*domain.Box, error)
+	Update(id string, ownerID uint, isAdmin bool, title, description string) (*domain.Box, error)
+	Delete(id string, ownerID uint, isAdmin bool) error
+}
+
+type boxService struct {
+	db    *gorm.DB
+	boxes repository.BoxRepository
+}
+
+// NewBoxService builds a BoxService backed by the given repository. db is
+// used to open transactions for operations that touch more than one table.
+func NewBoxService(db *gorm.DB, boxes repository.BoxRepository) BoxService {
+	return &boxService{db: db, boxes: boxes}
+}
+
+func (s *boxService) List(ownerID uint, params repository.ListParams) ([]domain.Box, int64, error) {
+	return s.boxes.FindAllByOwner(ownerID, params)
+}
+
+func (s *boxService) ListAll(params repository.ListParams) ([]domain.Box, int64, error) {
+	return s.boxes.FindAll(params)
+}
+
+func (s *boxService) Get(id string, ownerID uint, isAdmin bool) (*domain.Box, error) {
+	box, err := s.boxes.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && box.OwnerID != ownerID {
+		return nil, ErrForbidden
+	}
+	return box, nil
+}
+
+func (s *boxService) Create(ownerID uint, title, description string) (*domain.Box, error) {
+	box := &domain.Box{Title: title, Description: description, OwnerID: ownerID}
+	if err := s.boxes.Create(box); err != nil {
+		return nil, err
+	}
+	return box, nil
+}
+
+func (s *boxService) Update(id string, ownerID uint, isAdmin bool, title, description string) (*domain.Box, error) {
+	box, err := s.Get(id, ownerID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	box.Title = title
+	box.Description = description
+	if err := s.boxes.Update(box); err != nil {
+		return nil, err
+	}
+	return box, nil
+}
+
+// Delete removes box. Its ideas cascade via the OnDelete:CASCADE foreign
+// key, so the whole operation stays a single atomic transaction rather
+// than the two separate statements this used to take.
+func (s *boxService) Delete(id string, ownerID uint, isAdmin bool) error {
+	box, err := s.Get(id, ownerID, isAdmin)
+	if err != nil {
+		return err
+	}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		return s.boxes.WithTx(tx).Delete(box)
+	})
+}
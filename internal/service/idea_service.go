@@ -0,0 +1,161 @@
+package service
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"github.com/mitermitek/Idea-Box-API/internal/repository"
+)
+
+// ErrTagNotFound is returned when AttachTags is given a tag ID that doesn't
+// exist.
+var ErrTagNotFound = errors.New("tag not found")
+
+// IdeaService holds the business logic for Ideas.
+type IdeaService interface {
+	List(boxID string, ownerID uint, isAdmin bool, params repository.ListParams) ([]domain.Idea, int64, error)
+	Get(boxID, ideaID string, ownerID uint, isAdmin bool) (*domain.Idea, error)
+	Create(boxID string, ownerID uint, isAdmin bool, title, description string) (*domain.Idea, error)
+	Update(boxID, ideaID string, ownerID uint, isAdmin bool, title, description string) (*domain.Idea, error)
+	Delete(boxID, ideaID string, ownerID uint, isAdmin bool) error
+	AttachTags(boxID, ideaID string, ownerID uint, isAdmin bool, tagIDs []uint) (*domain.Idea, error)
+	DetachTag(boxID, ideaID, tagID string, ownerID uint, isAdmin bool) (*domain.Idea, error)
+}
+
+type ideaService struct {
+	db    *gorm.DB
+	boxes repository.BoxRepository
+	ideas repository.IdeaRepository
+	tags  repository.TagRepository
+}
+
+// NewIdeaService builds an IdeaService backed by the given repositories. db
+// is used to open transactions for operations that touch more than one
+// table, such as attaching tags.
+func NewIdeaService(db *gorm.DB, boxes repository.BoxRepository, ideas repository.IdeaRepository, tags repository.TagRepository) IdeaService {
+	return &ideaService{db: db, boxes: boxes, ideas: ideas, tags: tags}
+}
+
+func (s *ideaService) ownedBox(boxID string, ownerID uint, isAdmin bool) (*domain.Box, error) {
+	box, err := s.boxes.FindByID(boxID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && box.OwnerID != ownerID {
+		return nil, ErrForbidden
+	}
+	return box, nil
+}
+
+func (s *ideaService) List(boxID string, ownerID uint, isAdmin bool, params repository.ListParams) ([]domain.Idea, int64, error) {
+	if _, err := s.ownedBox(boxID, ownerID, isAdmin); err != nil {
+		return nil, 0, err
+	}
+	return s.ideas.FindByBoxID(boxID, params)
+}
+
+func (s *ideaService) Get(boxID, ideaID string, ownerID uint, isAdmin bool) (*domain.Idea, error) {
+	if _, err := s.ownedBox(boxID, ownerID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.ideas.FindByID(boxID, ideaID)
+}
+
+func (s *ideaService) Create(boxID string, ownerID uint, isAdmin bool, title, description string) (*domain.Idea, error) {
+	box, err := s.ownedBox(boxID, ownerID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	idea := &domain.Idea{
+		Title:       title,
+		Description: description,
+		BoxID:       box.ID,
+		OwnerID:     box.OwnerID,
+	}
+	if err := s.ideas.Create(idea); err != nil {
+		return nil, err
+	}
+	return idea, nil
+}
+
+func (s *ideaService) Update(boxID, ideaID string, ownerID uint, isAdmin bool, title, description string) (*domain.Idea, error) {
+	if _, err := s.ownedBox(boxID, ownerID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	idea, err := s.ideas.FindByID(boxID, ideaID)
+	if err != nil {
+		return nil, err
+	}
+
+	idea.Title = title
+	idea.Description = description
+	if err := s.ideas.Update(idea); err != nil {
+		return nil, err
+	}
+	return idea, nil
+}
+
+func (s *ideaService) Delete(boxID, ideaID string, ownerID uint, isAdmin bool) error {
+	if _, err := s.ownedBox(boxID, ownerID, isAdmin); err != nil {
+		return err
+	}
+
+	idea, err := s.ideas.FindByID(boxID, ideaID)
+	if err != nil {
+		return err
+	}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		return s.ideas.WithTx(tx).Delete(idea)
+	})
+}
+
+func (s *ideaService) AttachTags(boxID, ideaID string, ownerID uint, isAdmin bool, tagIDs []uint) (*domain.Idea, error) {
+	if _, err := s.ownedBox(boxID, ownerID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	idea, err := s.ideas.FindByID(boxID, ideaID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		tags, err := s.tags.WithTx(tx).FindByIDs(tagIDs)
+		if err != nil {
+			return err
+		}
+		if len(tags) != len(tagIDs) {
+			return ErrTagNotFound
+		}
+		return s.ideas.WithTx(tx).AttachTags(idea, tags)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.ideas.FindByID(boxID, ideaID)
+}
+
+func (s *ideaService) DetachTag(boxID, ideaID, tagID string, ownerID uint, isAdmin bool) (*domain.Idea, error) {
+	if _, err := s.ownedBox(boxID, ownerID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	idea, err := s.ideas.FindByID(boxID, ideaID)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.tags.FindByID(tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ideas.DetachTag(idea, tag); err != nil {
+		return nil, err
+	}
+	return s.ideas.FindByID(boxID, ideaID)
+}
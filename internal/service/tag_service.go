@@ -0,0 +1,81 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"github.com/mitermitek/Idea-Box-API/internal/repository"
+)
+
+var slugInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// normalizeSlug lowercases and hyphenates a tag slug, e.g. "Fun Ideas!" -> "fun-ideas".
+func normalizeSlug(s string) string {
+	slug := slugInvalidChars.ReplaceAllString(strings.ToLower(s), "-")
+	return strings.Trim(slug, "-")
+}
+
+// TagService holds the business logic for Tags.
+type TagService interface {
+	List() ([]domain.Tag, error)
+	Get(id string) (*domain.Tag, error)
+	Create(name, slug string) (*domain.Tag, error)
+	Update(id, name, slug string) (*domain.Tag, error)
+	Delete(id string) error
+}
+
+type tagService struct {
+	tags repository.TagRepository
+}
+
+// NewTagService builds a TagService backed by the given repository.
+func NewTagService(tags repository.TagRepository) TagService {
+	return &tagService{tags: tags}
+}
+
+func (s *tagService) List() ([]domain.Tag, error) {
+	return s.tags.FindAll()
+}
+
+func (s *tagService) Get(id string) (*domain.Tag, error) {
+	return s.tags.FindByID(id)
+}
+
+func (s *tagService) Create(name, slug string) (*domain.Tag, error) {
+	if slug == "" {
+		slug = name
+	}
+
+	tag := &domain.Tag{Name: name, Slug: normalizeSlug(slug)}
+	if err := s.tags.Create(tag); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (s *tagService) Update(id, name, slug string) (*domain.Tag, error) {
+	tag, err := s.tags.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if slug == "" {
+		slug = name
+	}
+
+	tag.Name = name
+	tag.Slug = normalizeSlug(slug)
+	if err := s.tags.Update(tag); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (s *tagService) Delete(id string) error {
+	tag, err := s.tags.FindByID(id)
+	if err != nil {
+		return err
+	}
+	return s.tags.Delete(tag)
+}
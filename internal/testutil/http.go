@@ -0,0 +1,73 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// DoJSON issues method/path against server with body marshaled as JSON (nil
+// for no body) and an optional bearer token, decoding the response body
+// into out (nil to discard it). It returns the response status code.
+func DoJSON(t *testing.T, server string, method, path string, body any, token string, out any) int {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("marshal request body: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, server+path, reader)
+	if err != nil {
+		t.Fatalf("build request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("do request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			t.Fatalf("decode response body: %v", err)
+		}
+	}
+
+	return resp.StatusCode
+}
+
+// RegisterAndLogin creates a user on server and returns a bearer token for it.
+func RegisterAndLogin(t *testing.T, server, email, password string) string {
+	t.Helper()
+
+	if status := DoJSON(t, server, http.MethodPost, "/auth/register", map[string]string{
+		"email":    email,
+		"password": password,
+	}, "", nil); status != http.StatusCreated {
+		t.Fatalf("register: expected 201, got %d", status)
+	}
+
+	var login struct {
+		Token string `json:"token"`
+	}
+	if status := DoJSON(t, server, http.MethodPost, "/auth/login", map[string]string{
+		"email":    email,
+		"password": password,
+	}, "", &login); status != http.StatusOK {
+		t.Fatalf("login: expected 200, got %d", status)
+	}
+
+	return login.Token
+}
@@ -0,0 +1,79 @@
+// Package testutil provides shared helpers for spinning up an
+// Idea-Box-API instance backed by an in-memory SQLite database, for use
+// from integration tests across the codebase.
+package testutil
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"github.com/mitermitek/Idea-Box-API/internal/repository"
+	"github.com/mitermitek/Idea-Box-API/internal/service"
+	httptransport "github.com/mitermitek/Idea-Box-API/internal/transport/http"
+)
+
+// NewDB opens a fresh in-memory SQLite database scoped to t, migrates the
+// schema, and closes the connection when the test finishes.
+func NewDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_pragma=foreign_keys(1)", strings.ReplaceAll(t.Name(), "/", "_"))
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+
+	// A shared-cache in-memory database is dropped once its last
+	// connection closes, so pin the pool to a single connection.
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("get underlying sql.DB: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	if err := db.AutoMigrate(&domain.User{}, &domain.Box{}, &domain.Idea{}, &domain.Tag{}); err != nil {
+		t.Fatalf("migrate test database: %v", err)
+	}
+	if err := repository.EnsureFTS(db); err != nil {
+		t.Fatalf("set up full-text search: %v", err)
+	}
+
+	t.Cleanup(func() {
+		sqlDB.Close()
+	})
+
+	return db
+}
+
+// NewServer wires a router identical to cmd/server/main.go on top of db
+// and returns a running httptest.Server, closed automatically when the
+// test finishes.
+func NewServer(t *testing.T, db *gorm.DB) *httptest.Server {
+	t.Helper()
+
+	userRepo := repository.NewUserRepository(db)
+	boxRepo := repository.NewBoxRepository(db)
+	ideaRepo := repository.NewIdeaRepository(db)
+	tagRepo := repository.NewTagRepository(db)
+
+	authService := service.NewAuthService(userRepo, []byte("test-secret"))
+	boxService := service.NewBoxService(db, boxRepo)
+	ideaService := service.NewIdeaService(db, boxRepo, ideaRepo, tagRepo)
+	tagService := service.NewTagService(tagRepo)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	httptransport.RegisterRoutes(router.Group("/"), authService, boxService, ideaService, tagService)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	return server
+}
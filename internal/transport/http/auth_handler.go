@@ -0,0 +1,72 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mitermitek/Idea-Box-API/internal/service"
+)
+
+type AuthHandler struct {
+	auth service.AuthService
+}
+
+func NewAuthHandler(auth service.AuthService) *AuthHandler {
+	return &AuthHandler{auth: auth}
+}
+
+// register creates a new user account.
+//
+//	@Summary	Register
+//	@Tags		auth
+//	@Param		credentials	body		RegisterRequest	true	"Account to create"
+//	@Success	201			{object}	UserResponse
+//	@Failure	400			{object}	ErrorResponse
+//	@Failure	409			{object}	ErrorResponse
+//	@Router		/auth/register [post]
+func (h *AuthHandler) register(c *gin.Context) {
+	var request RegisterRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	user, err := h.auth.Register(request.Email, request.Password)
+	if err != nil {
+		if errors.Is(err, service.ErrEmailTaken) {
+			c.JSON(http.StatusConflict, ErrorResponse{Error: err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newUserResponse(user))
+}
+
+// login exchanges valid credentials for a JWT.
+//
+//	@Summary	Log in
+//	@Tags		auth
+//	@Param		credentials	body		LoginRequest	true	"Account credentials"
+//	@Success	200			{object}	TokenResponse
+//	@Failure	400			{object}	ErrorResponse
+//	@Failure	401			{object}	ErrorResponse
+//	@Router		/auth/login [post]
+func (h *AuthHandler) login(c *gin.Context) {
+	var request LoginRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	token, err := h.auth.Login(request.Email, request.Password)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, TokenResponse{Token: token})
+}
@@ -0,0 +1,179 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mitermitek/Idea-Box-API/internal/service"
+)
+
+var boxSortColumns = map[string]bool{"id": true, "title": true, "description": true}
+
+type BoxHandler struct {
+	boxes service.BoxService
+}
+
+func NewBoxHandler(boxes service.BoxService) *BoxHandler {
+	return &BoxHandler{boxes: boxes}
+}
+
+// getBoxes lists the boxes owned by the caller.
+//
+//	@Summary		List your boxes
+//	@Tags			boxes
+//	@Security		BearerAuth
+//	@Param			limit		query		int		false	"max rows to return (default 50, max 200)"
+//	@Param			offset		query		int		false	"rows to skip (default 0)"
+//	@Param			sort_column	query		string	false	"id, title, or description"
+//	@Param			sort_order	query		string	false	"asc or desc"
+//	@Param			q			query		string	false	"full-text search over title and description"
+//	@Success		200			{object}	ListResponse[BoxResponse]
+//	@Failure		400			{object}	ErrorResponse
+//	@Router			/boxes [get]
+func (h *BoxHandler) getBoxes(c *gin.Context) {
+	params, err := parseListParams(c, "id", boxSortColumns)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	boxes, total, err := h.boxes.List(userID(c), params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, newBoxListResponse(boxes, total, params))
+}
+
+// getAllBoxes lists every box across all users.
+//
+//	@Summary		List all boxes (admin)
+//	@Tags			admin
+//	@Security		BearerAuth
+//	@Param			limit		query		int		false	"max rows to return (default 50, max 200)"
+//	@Param			offset		query		int		false	"rows to skip (default 0)"
+//	@Param			sort_column	query		string	false	"id, title, or description"
+//	@Param			sort_order	query		string	false	"asc or desc"
+//	@Param			q			query		string	false	"full-text search over title and description"
+//	@Success		200			{object}	ListResponse[BoxResponse]
+//	@Failure		400			{object}	ErrorResponse
+//	@Failure		403			{object}	ErrorResponse
+//	@Router			/admin/boxes [get]
+func (h *BoxHandler) getAllBoxes(c *gin.Context) {
+	params, err := parseListParams(c, "id", boxSortColumns)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	boxes, total, err := h.boxes.ListAll(params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, newBoxListResponse(boxes, total, params))
+}
+
+// getBox fetches a single box owned by the caller.
+//
+//	@Summary	Get a box
+//	@Tags		boxes
+//	@Security	BearerAuth
+//	@Param		id	path		int	true	"Box ID"
+//	@Success	200	{object}	BoxResponse
+//	@Failure	403	{object}	ErrorResponse
+//	@Failure	404	{object}	ErrorResponse
+//	@Router		/boxes/{id} [get]
+func (h *BoxHandler) getBox(c *gin.Context) {
+	box, err := h.boxes.Get(c.Param("id"), userID(c), isAdmin(c))
+	if err != nil {
+		respondBoxError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newBoxResponse(box))
+}
+
+// createBox creates a box owned by the caller.
+//
+//	@Summary	Create a box
+//	@Tags		boxes
+//	@Security	BearerAuth
+//	@Param		box	body		BoxRequest	true	"Box to create"
+//	@Success	201	{object}	BoxResponse
+//	@Failure	400	{object}	ErrorResponse
+//	@Router		/boxes [post]
+func (h *BoxHandler) createBox(c *gin.Context) {
+	var request BoxRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	box, err := h.boxes.Create(userID(c), request.Title, request.Description)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newBoxResponse(box))
+}
+
+// updateBox updates a box owned by the caller.
+//
+//	@Summary	Update a box
+//	@Tags		boxes
+//	@Security	BearerAuth
+//	@Param		id	path		int			true	"Box ID"
+//	@Param		box	body		BoxRequest	true	"Updated box fields"
+//	@Success	200	{object}	BoxResponse
+//	@Failure	400	{object}	ErrorResponse
+//	@Failure	403	{object}	ErrorResponse
+//	@Failure	404	{object}	ErrorResponse
+//	@Router		/boxes/{id} [put]
+func (h *BoxHandler) updateBox(c *gin.Context) {
+	var request BoxRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	box, err := h.boxes.Update(c.Param("id"), userID(c), isAdmin(c), request.Title, request.Description)
+	if err != nil {
+		respondBoxError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newBoxResponse(box))
+}
+
+// deleteBox deletes a box owned by the caller, cascading to its ideas.
+//
+//	@Summary	Delete a box
+//	@Tags		boxes
+//	@Security	BearerAuth
+//	@Param		id	path	int	true	"Box ID"
+//	@Success	204
+//	@Failure	403	{object}	ErrorResponse
+//	@Failure	404	{object}	ErrorResponse
+//	@Router		/boxes/{id} [delete]
+func (h *BoxHandler) deleteBox(c *gin.Context) {
+	if err := h.boxes.Delete(c.Param("id"), userID(c), isAdmin(c)); err != nil {
+		respondBoxError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, gin.H{})
+}
+
+func respondBoxError(c *gin.Context, err error) {
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusNotFound, ErrorResponse{Error: "box not found"})
+}
@@ -0,0 +1,122 @@
+package http
+
+import (
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"github.com/mitermitek/Idea-Box-API/internal/repository"
+)
+
+// ErrorResponse is the body returned for every non-2xx response.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+type RegisterRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=8"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+type UserResponse struct {
+	ID    uint        `json:"id"`
+	Email string      `json:"email"`
+	Role  domain.Role `json:"role"`
+}
+
+type TokenResponse struct {
+	Token string `json:"token"`
+}
+
+func newUserResponse(user *domain.User) UserResponse {
+	return UserResponse{ID: user.ID, Email: user.Email, Role: user.Role}
+}
+
+type BoxRequest struct {
+	Title       string `json:"title" binding:"required"`
+	Description string `json:"description"`
+}
+
+type IdeaRequest struct {
+	Title       string `json:"title" binding:"required"`
+	Description string `json:"description"`
+}
+
+type BoxResponse struct {
+	ID          uint           `json:"id"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Ideas       []IdeaResponse `json:"ideas"`
+}
+
+type IdeaResponse struct {
+	ID          uint          `json:"id"`
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	Tags        []TagResponse `json:"tags"`
+}
+
+type TagRequest struct {
+	Name string `json:"name" binding:"required"`
+	Slug string `json:"slug"`
+}
+
+type TagResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type AttachTagsRequest struct {
+	TagIDs []uint `json:"tagIds" binding:"required"`
+}
+
+func newTagResponse(tag *domain.Tag) TagResponse {
+	return TagResponse{ID: tag.ID, Name: tag.Name, Slug: tag.Slug}
+}
+
+func newBoxResponse(box *domain.Box) BoxResponse {
+	ideaResponses := make([]IdeaResponse, 0)
+	for _, idea := range box.Ideas {
+		ideaResponses = append(ideaResponses, newIdeaResponse(&idea))
+	}
+
+	return BoxResponse{
+		ID:          box.ID,
+		Title:       box.Title,
+		Description: box.Description,
+		Ideas:       ideaResponses,
+	}
+}
+
+func newIdeaResponse(idea *domain.Idea) IdeaResponse {
+	tagResponses := make([]TagResponse, 0)
+	for _, tag := range idea.Tags {
+		tagResponses = append(tagResponses, newTagResponse(&tag))
+	}
+
+	return IdeaResponse{
+		ID:          idea.ID,
+		Title:       idea.Title,
+		Description: idea.Description,
+		Tags:        tagResponses,
+	}
+}
+
+func newBoxListResponse(boxes []domain.Box, total int64, params repository.ListParams) ListResponse[BoxResponse] {
+	responses := make([]BoxResponse, 0)
+	for _, box := range boxes {
+		responses = append(responses, newBoxResponse(&box))
+	}
+	return ListResponse[BoxResponse]{Data: responses, Total: total, Limit: params.Limit, Offset: params.Offset}
+}
+
+func newIdeaListResponse(ideas []domain.Idea, total int64, params repository.ListParams) ListResponse[IdeaResponse] {
+	responses := make([]IdeaResponse, 0)
+	for _, idea := range ideas {
+		responses = append(responses, newIdeaResponse(&idea))
+	}
+	return ListResponse[IdeaResponse]{Data: responses, Total: total, Limit: params.Limit, Offset: params.Offset}
+}
@@ -0,0 +1,505 @@
+package http_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"github.com/mitermitek/Idea-Box-API/internal/service"
+	"github.com/mitermitek/Idea-Box-API/internal/testutil"
+)
+
+type boxResponse struct {
+	ID          uint   `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+type ideaResponse struct {
+	ID          uint          `json:"id"`
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	Tags        []tagResponse `json:"tags"`
+}
+
+type tagResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+func newAuthedServer(t *testing.T) (server string, token string) {
+	t.Helper()
+	db := testutil.NewDB(t)
+	server = testutil.NewServer(t, db).URL
+	token = testutil.RegisterAndLogin(t, server, fmt.Sprintf("%s@example.com", t.Name()), "hunter22")
+	return server, token
+}
+
+func TestBoxCRUD(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	var created boxResponse
+	status := testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{
+		"title":       "Launch ideas",
+		"description": "Things to ship",
+	}, token, &created)
+	if status != http.StatusCreated {
+		t.Fatalf("create box: expected 201, got %d", status)
+	}
+	if created.Title != "Launch ideas" {
+		t.Fatalf("create box: unexpected title %q", created.Title)
+	}
+
+	boxPath := fmt.Sprintf("/boxes/%d", created.ID)
+
+	var fetched boxResponse
+	if status := testutil.DoJSON(t, server, http.MethodGet, boxPath, nil, token, &fetched); status != http.StatusOK {
+		t.Fatalf("get box: expected 200, got %d", status)
+	}
+
+	var updated boxResponse
+	status = testutil.DoJSON(t, server, http.MethodPut, boxPath, map[string]string{
+		"title":       "Renamed",
+		"description": "Updated",
+	}, token, &updated)
+	if status != http.StatusOK || updated.Title != "Renamed" {
+		t.Fatalf("update box: expected 200 with new title, got %d title %q", status, updated.Title)
+	}
+
+	if status := testutil.DoJSON(t, server, http.MethodDelete, boxPath, nil, token, nil); status != http.StatusNoContent {
+		t.Fatalf("delete box: expected 204, got %d", status)
+	}
+
+	if status := testutil.DoJSON(t, server, http.MethodGet, boxPath, nil, token, nil); status != http.StatusNotFound {
+		t.Fatalf("get deleted box: expected 404, got %d", status)
+	}
+}
+
+func TestBoxNotFound(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	for _, tc := range []struct {
+		name   string
+		method string
+		path   string
+		body   any
+	}{
+		{"get", http.MethodGet, "/boxes/999", nil},
+		{"update", http.MethodPut, "/boxes/999", map[string]string{"title": "x"}},
+		{"delete", http.MethodDelete, "/boxes/999", nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			status := testutil.DoJSON(t, server, tc.method, tc.path, tc.body, token, nil)
+			if status != http.StatusNotFound {
+				t.Fatalf("expected 404, got %d", status)
+			}
+		})
+	}
+}
+
+func TestCreateBoxInvalidJSON(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	status := testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{
+		"description": "missing required title",
+	}, token, nil)
+	if status != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", status)
+	}
+}
+
+func TestIdeaCRUD(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	var box boxResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{"title": "Box"}, token, &box)
+	ideasPath := fmt.Sprintf("/boxes/%d/ideas", box.ID)
+
+	var created ideaResponse
+	status := testutil.DoJSON(t, server, http.MethodPost, ideasPath, map[string]string{
+		"title":       "Ship it",
+		"description": "Do the thing",
+	}, token, &created)
+	if status != http.StatusCreated {
+		t.Fatalf("create idea: expected 201, got %d", status)
+	}
+
+	ideaPath := fmt.Sprintf("%s/%d", ideasPath, created.ID)
+
+	var fetched ideaResponse
+	if status := testutil.DoJSON(t, server, http.MethodGet, ideaPath, nil, token, &fetched); status != http.StatusOK {
+		t.Fatalf("get idea: expected 200, got %d", status)
+	}
+
+	var updated ideaResponse
+	status = testutil.DoJSON(t, server, http.MethodPut, ideaPath, map[string]string{
+		"title":       "Ship it faster",
+		"description": "Do the thing now",
+	}, token, &updated)
+	if status != http.StatusOK || updated.Title != "Ship it faster" {
+		t.Fatalf("update idea: expected 200 with new title, got %d title %q", status, updated.Title)
+	}
+
+	if status := testutil.DoJSON(t, server, http.MethodDelete, ideaPath, nil, token, nil); status != http.StatusNoContent {
+		t.Fatalf("delete idea: expected 204, got %d", status)
+	}
+
+	if status := testutil.DoJSON(t, server, http.MethodGet, ideaPath, nil, token, nil); status != http.StatusNotFound {
+		t.Fatalf("get deleted idea: expected 404, got %d", status)
+	}
+}
+
+func TestCreateIdeaInvalidJSON(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	var box boxResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{"title": "Box"}, token, &box)
+
+	status := testutil.DoJSON(t, server, http.MethodPost, fmt.Sprintf("/boxes/%d/ideas", box.ID), map[string]string{
+		"description": "missing required title",
+	}, token, nil)
+	if status != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", status)
+	}
+}
+
+func TestGetBoxIdeasFilteredByTagOverlap(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	var box boxResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{"title": "Box"}, token, &box)
+	ideasPath := fmt.Sprintf("/boxes/%d/ideas", box.ID)
+
+	var tagged, untagged ideaResponse
+	testutil.DoJSON(t, server, http.MethodPost, ideasPath, map[string]string{"title": "Tagged"}, token, &tagged)
+	testutil.DoJSON(t, server, http.MethodPost, ideasPath, map[string]string{"title": "Untagged"}, token, &untagged)
+
+	var tagA, tagB tagResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/tags", map[string]string{"name": "Alpha", "slug": "alpha"}, token, &tagA)
+	testutil.DoJSON(t, server, http.MethodPost, "/tags", map[string]string{"name": "Beta", "slug": "beta"}, token, &tagB)
+
+	status := testutil.DoJSON(t, server, http.MethodPost, fmt.Sprintf("%s/%d/tags", ideasPath, tagged.ID), map[string]any{
+		"tagIds": []uint{tagA.ID, tagB.ID},
+	}, token, nil)
+	if status != http.StatusOK {
+		t.Fatalf("attach tags: expected 200, got %d", status)
+	}
+
+	var list struct {
+		Data  []ideaResponse `json:"data"`
+		Total int64          `json:"total"`
+	}
+	path := ideasPath + "?tag=alpha&tag=beta"
+	if status := testutil.DoJSON(t, server, http.MethodGet, path, nil, token, &list); status != http.StatusOK {
+		t.Fatalf("list ideas by tag: expected 200, got %d", status)
+	}
+	if list.Total != 1 {
+		t.Fatalf("expected the tagged idea counted once despite matching both slugs, got total %d", list.Total)
+	}
+	if len(list.Data) != 1 || list.Data[0].ID != tagged.ID {
+		t.Fatalf("expected only the tagged idea in the results, got %+v", list.Data)
+	}
+}
+
+func TestCascadeDeleteIdeasWhenBoxDeleted(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	var box boxResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{"title": "Box"}, token, &box)
+
+	var idea ideaResponse
+	testutil.DoJSON(t, server, http.MethodPost, fmt.Sprintf("/boxes/%d/ideas", box.ID), map[string]string{
+		"title": "Idea",
+	}, token, &idea)
+
+	boxPath := fmt.Sprintf("/boxes/%d", box.ID)
+	if status := testutil.DoJSON(t, server, http.MethodDelete, boxPath, nil, token, nil); status != http.StatusNoContent {
+		t.Fatalf("delete box: expected 204, got %d", status)
+	}
+
+	ideaPath := fmt.Sprintf("/boxes/%d/ideas/%d", box.ID, idea.ID)
+	if status := testutil.DoJSON(t, server, http.MethodGet, ideaPath, nil, token, nil); status != http.StatusNotFound {
+		t.Fatalf("get idea under deleted box: expected 404, got %d", status)
+	}
+}
+
+func TestDeleteBoxPurgesIdeaFTSRows(t *testing.T) {
+	db := testutil.NewDB(t)
+	server := testutil.NewServer(t, db).URL
+	token := testutil.RegisterAndLogin(t, server, fmt.Sprintf("%s@example.com", t.Name()), "hunter22")
+
+	var box boxResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{"title": "Box"}, token, &box)
+
+	var idea ideaResponse
+	testutil.DoJSON(t, server, http.MethodPost, fmt.Sprintf("/boxes/%d/ideas", box.ID), map[string]string{
+		"title": "Idea",
+	}, token, &idea)
+
+	boxPath := fmt.Sprintf("/boxes/%d", box.ID)
+	if status := testutil.DoJSON(t, server, http.MethodDelete, boxPath, nil, token, nil); status != http.StatusNoContent {
+		t.Fatalf("delete box: expected 204, got %d", status)
+	}
+
+	var count int64
+	if err := db.Table("idea_fts").Where("rowid = ?", idea.ID).Count(&count).Error; err != nil {
+		t.Fatalf("count idea_fts rows: %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("expected the idea's idea_fts row to be purged when its box cascades, found %d", count)
+	}
+}
+
+func TestAttachTagsRejectsUnknownTagID(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	var box boxResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{"title": "Box"}, token, &box)
+
+	var idea ideaResponse
+	testutil.DoJSON(t, server, http.MethodPost, fmt.Sprintf("/boxes/%d/ideas", box.ID), map[string]string{
+		"title": "Idea",
+	}, token, &idea)
+
+	var tag tagResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/tags", map[string]string{"name": "Alpha", "slug": "alpha"}, token, &tag)
+
+	attachPath := fmt.Sprintf("/boxes/%d/ideas/%d/tags", box.ID, idea.ID)
+	status := testutil.DoJSON(t, server, http.MethodPost, attachPath, map[string]any{"tagIds": []uint{tag.ID, 999}}, token, nil)
+	if status != http.StatusNotFound {
+		t.Fatalf("attach tags with one unknown ID: expected 404, got %d", status)
+	}
+
+	var fetched ideaResponse
+	if status := testutil.DoJSON(t, server, http.MethodGet, fmt.Sprintf("/boxes/%d/ideas/%d", box.ID, idea.ID), nil, token, &fetched); status != http.StatusOK {
+		t.Fatalf("get idea: expected 200, got %d", status)
+	}
+	if len(fetched.Tags) != 0 {
+		t.Fatalf("expected no tags attached after a rejected request, got %d", len(fetched.Tags))
+	}
+}
+
+func TestDeleteBoxWithTaggedIdeaSucceeds(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	var box boxResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{"title": "Box"}, token, &box)
+
+	var idea ideaResponse
+	testutil.DoJSON(t, server, http.MethodPost, fmt.Sprintf("/boxes/%d/ideas", box.ID), map[string]string{
+		"title": "Idea",
+	}, token, &idea)
+
+	var tag tagResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/tags", map[string]string{"name": "Alpha", "slug": "alpha"}, token, &tag)
+
+	attachPath := fmt.Sprintf("/boxes/%d/ideas/%d/tags", box.ID, idea.ID)
+	if status := testutil.DoJSON(t, server, http.MethodPost, attachPath, map[string]any{"tagIds": []uint{tag.ID}}, token, nil); status != http.StatusOK {
+		t.Fatalf("attach tag: expected 200, got %d", status)
+	}
+
+	boxPath := fmt.Sprintf("/boxes/%d", box.ID)
+	if status := testutil.DoJSON(t, server, http.MethodDelete, boxPath, nil, token, nil); status != http.StatusNoContent {
+		t.Fatalf("delete box with tagged idea: expected 204, got %d", status)
+	}
+}
+
+func TestDeleteIdeaWithTagsSucceeds(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	var box boxResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{"title": "Box"}, token, &box)
+
+	var idea ideaResponse
+	testutil.DoJSON(t, server, http.MethodPost, fmt.Sprintf("/boxes/%d/ideas", box.ID), map[string]string{
+		"title": "Idea",
+	}, token, &idea)
+
+	var tag tagResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/tags", map[string]string{"name": "Alpha", "slug": "alpha"}, token, &tag)
+
+	ideaPath := fmt.Sprintf("/boxes/%d/ideas/%d", box.ID, idea.ID)
+	if status := testutil.DoJSON(t, server, http.MethodPost, ideaPath+"/tags", map[string]any{"tagIds": []uint{tag.ID}}, token, nil); status != http.StatusOK {
+		t.Fatalf("attach tag: expected 200, got %d", status)
+	}
+
+	if status := testutil.DoJSON(t, server, http.MethodDelete, ideaPath, nil, token, nil); status != http.StatusNoContent {
+		t.Fatalf("delete tagged idea: expected 204, got %d", status)
+	}
+}
+
+func TestListBoxesSearchWithSortColumn(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{
+		"title":       "Launch ideas",
+		"description": "Things to ship",
+	}, token, nil)
+
+	var list struct {
+		Total int64 `json:"total"`
+	}
+	path := "/boxes?q=launch&sort_column=title&sort_order=asc"
+	if status := testutil.DoJSON(t, server, http.MethodGet, path, nil, token, &list); status != http.StatusOK {
+		t.Fatalf("list boxes with q and sort_column=title: expected 200, got %d", status)
+	}
+	if list.Total != 1 {
+		t.Fatalf("expected 1 matching box, got %d", list.Total)
+	}
+}
+
+func TestListIdeasSearchWithSortColumn(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	var box boxResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{"title": "Box"}, token, &box)
+	testutil.DoJSON(t, server, http.MethodPost, fmt.Sprintf("/boxes/%d/ideas", box.ID), map[string]string{
+		"title":       "Launch plan",
+		"description": "Ship it",
+	}, token, nil)
+
+	var list struct {
+		Total int64 `json:"total"`
+	}
+	path := fmt.Sprintf("/boxes/%d/ideas?q=launch&sort_column=description&sort_order=desc", box.ID)
+	if status := testutil.DoJSON(t, server, http.MethodGet, path, nil, token, &list); status != http.StatusOK {
+		t.Fatalf("list ideas with q and sort_column=description: expected 200, got %d", status)
+	}
+	if list.Total != 1 {
+		t.Fatalf("expected 1 matching idea, got %d", list.Total)
+	}
+}
+
+func TestConcurrentIdeaCreation(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	var box boxResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{"title": "Box"}, token, &box)
+	ideasPath := fmt.Sprintf("/boxes/%d/ideas", box.ID)
+
+	const goroutines = 20
+
+	var wg sync.WaitGroup
+	statuses := make([]int, goroutines)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			statuses[i] = testutil.DoJSON(t, server, http.MethodPost, ideasPath, map[string]string{
+				"title": fmt.Sprintf("Idea %d", i),
+			}, token, nil)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, status := range statuses {
+		if status != http.StatusCreated {
+			t.Errorf("goroutine %d: expected 201, got %d", i, status)
+		}
+	}
+
+	var list struct {
+		Total int64 `json:"total"`
+	}
+	if status := testutil.DoJSON(t, server, http.MethodGet, ideasPath+"?limit=1", nil, token, &list); status != http.StatusOK {
+		t.Fatalf("list ideas: expected 200, got %d", status)
+	}
+	if list.Total != goroutines {
+		t.Fatalf("expected %d ideas, got %d", goroutines, list.Total)
+	}
+}
+
+func TestCrossUserAccessIsForbidden(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	var box boxResponse
+	testutil.DoJSON(t, server, http.MethodPost, "/boxes", map[string]string{"title": "Box"}, token, &box)
+
+	var idea ideaResponse
+	testutil.DoJSON(t, server, http.MethodPost, fmt.Sprintf("/boxes/%d/ideas", box.ID), map[string]string{
+		"title": "Idea",
+	}, token, &idea)
+
+	otherToken := testutil.RegisterAndLogin(t, server, fmt.Sprintf("%s-other@example.com", t.Name()), "hunter22")
+
+	boxPath := fmt.Sprintf("/boxes/%d", box.ID)
+	if status := testutil.DoJSON(t, server, http.MethodGet, boxPath, nil, otherToken, nil); status != http.StatusForbidden {
+		t.Fatalf("other user getting box: expected 403, got %d", status)
+	}
+
+	ideaPath := fmt.Sprintf("/boxes/%d/ideas/%d", box.ID, idea.ID)
+	if status := testutil.DoJSON(t, server, http.MethodGet, ideaPath, nil, otherToken, nil); status != http.StatusForbidden {
+		t.Fatalf("other user getting idea: expected 403, got %d", status)
+	}
+}
+
+func TestAdminBootstrapAndAccessControl(t *testing.T) {
+	server, token := newAuthedServer(t)
+
+	if status := testutil.DoJSON(t, server, http.MethodGet, "/admin/boxes", nil, token, nil); status != http.StatusOK {
+		t.Fatalf("expected the first registered user to be an admin, got %d", status)
+	}
+
+	otherToken := testutil.RegisterAndLogin(t, server, fmt.Sprintf("%s-second@example.com", t.Name()), "hunter22")
+	if status := testutil.DoJSON(t, server, http.MethodGet, "/admin/boxes", nil, otherToken, nil); status != http.StatusForbidden {
+		t.Fatalf("expected a non-admin user to get 403 from /admin/boxes, got %d", status)
+	}
+}
+
+func TestRegisterIsCaseInsensitiveOnEmail(t *testing.T) {
+	db := testutil.NewDB(t)
+	server := testutil.NewServer(t, db).URL
+
+	email := fmt.Sprintf("Mixed-%s@Example.com", t.Name())
+	if status := testutil.DoJSON(t, server, http.MethodPost, "/auth/register", map[string]string{
+		"email":    email,
+		"password": "hunter22",
+	}, "", nil); status != http.StatusCreated {
+		t.Fatalf("register: expected 201, got %d", status)
+	}
+
+	if status := testutil.DoJSON(t, server, http.MethodPost, "/auth/register", map[string]string{
+		"email":    strings.ToLower(email),
+		"password": "hunter22",
+	}, "", nil); status != http.StatusConflict {
+		t.Fatalf("re-register with different casing: expected 409, got %d", status)
+	}
+
+	var login struct {
+		Token string `json:"token"`
+	}
+	if status := testutil.DoJSON(t, server, http.MethodPost, "/auth/login", map[string]string{
+		"email":    strings.ToLower(email),
+		"password": "hunter22",
+	}, "", &login); status != http.StatusOK || login.Token == "" {
+		t.Fatalf("login with different casing: expected 200 with a token, got %d", status)
+	}
+}
+
+func TestAuthMiddlewareRejectsUnpinnedSigningAlgorithm(t *testing.T) {
+	server, _ := newAuthedServer(t)
+
+	claims := service.Claims{
+		UserID: 1,
+		Role:   domain.RoleUser,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS384, claims)
+	signed, err := token.SignedString([]byte("test-secret"))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+
+	if status := testutil.DoJSON(t, server, http.MethodGet, "/boxes", nil, signed, nil); status != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for a token signed with a non-HS256 algorithm, got %d", status)
+	}
+}
@@ -0,0 +1,216 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mitermitek/Idea-Box-API/internal/service"
+)
+
+var ideaSortColumns = map[string]bool{"id": true, "title": true, "description": true}
+
+type IdeaHandler struct {
+	ideas service.IdeaService
+}
+
+func NewIdeaHandler(ideas service.IdeaService) *IdeaHandler {
+	return &IdeaHandler{ideas: ideas}
+}
+
+// getBoxIdeas lists the ideas in a box owned by the caller.
+//
+//	@Summary		List a box's ideas
+//	@Tags			ideas
+//	@Security		BearerAuth
+//	@Param			id			path		int		true	"Box ID"
+//	@Param			limit		query		int		false	"max rows to return (default 50, max 200)"
+//	@Param			offset		query		int		false	"rows to skip (default 0)"
+//	@Param			sort_column	query		string	false	"id, title, or description"
+//	@Param			sort_order	query		string	false	"asc or desc"
+//	@Param			q			query		string	false	"full-text search over title and description"
+//	@Param			tag			query		[]string	false	"filter by tag slug, repeatable; matches any"
+//	@Success		200			{object}	ListResponse[IdeaResponse]
+//	@Failure		400			{object}	ErrorResponse
+//	@Failure		403			{object}	ErrorResponse
+//	@Failure		404			{object}	ErrorResponse
+//	@Router			/boxes/{id}/ideas [get]
+func (h *IdeaHandler) getBoxIdeas(c *gin.Context) {
+	params, err := parseListParams(c, "id", ideaSortColumns)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	params.TagSlugs = c.QueryArray("tag")
+
+	ideas, total, err := h.ideas.List(c.Param("id"), userID(c), isAdmin(c), params)
+	if err != nil {
+		respondIdeaError(c, err, "box not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, newIdeaListResponse(ideas, total, params))
+}
+
+// getBoxIdea fetches a single idea from a box owned by the caller.
+//
+//	@Summary	Get an idea
+//	@Tags		ideas
+//	@Security	BearerAuth
+//	@Param		id		path		int	true	"Box ID"
+//	@Param		ideaId	path		int	true	"Idea ID"
+//	@Success	200		{object}	IdeaResponse
+//	@Failure	403		{object}	ErrorResponse
+//	@Failure	404		{object}	ErrorResponse
+//	@Router		/boxes/{id}/ideas/{ideaId} [get]
+func (h *IdeaHandler) getBoxIdea(c *gin.Context) {
+	idea, err := h.ideas.Get(c.Param("id"), c.Param("ideaId"), userID(c), isAdmin(c))
+	if err != nil {
+		respondIdeaError(c, err, "idea not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, newIdeaResponse(idea))
+}
+
+// createBoxIdea creates an idea in a box owned by the caller.
+//
+//	@Summary	Create an idea
+//	@Tags		ideas
+//	@Security	BearerAuth
+//	@Param		id		path		int			true	"Box ID"
+//	@Param		idea	body		IdeaRequest	true	"Idea to create"
+//	@Success	201		{object}	IdeaResponse
+//	@Failure	400		{object}	ErrorResponse
+//	@Failure	403		{object}	ErrorResponse
+//	@Failure	404		{object}	ErrorResponse
+//	@Router		/boxes/{id}/ideas [post]
+func (h *IdeaHandler) createBoxIdea(c *gin.Context) {
+	var request IdeaRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	idea, err := h.ideas.Create(c.Param("id"), userID(c), isAdmin(c), request.Title, request.Description)
+	if err != nil {
+		respondIdeaError(c, err, "box not found")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newIdeaResponse(idea))
+}
+
+// updateBoxIdea updates an idea in a box owned by the caller.
+//
+//	@Summary	Update an idea
+//	@Tags		ideas
+//	@Security	BearerAuth
+//	@Param		id		path		int			true	"Box ID"
+//	@Param		ideaId	path		int			true	"Idea ID"
+//	@Param		idea	body		IdeaRequest	true	"Updated idea fields"
+//	@Success	200		{object}	IdeaResponse
+//	@Failure	400		{object}	ErrorResponse
+//	@Failure	403		{object}	ErrorResponse
+//	@Failure	404		{object}	ErrorResponse
+//	@Router		/boxes/{id}/ideas/{ideaId} [put]
+func (h *IdeaHandler) updateBoxIdea(c *gin.Context) {
+	var request IdeaRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	idea, err := h.ideas.Update(c.Param("id"), c.Param("ideaId"), userID(c), isAdmin(c), request.Title, request.Description)
+	if err != nil {
+		respondIdeaError(c, err, "idea not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, newIdeaResponse(idea))
+}
+
+// deleteBoxIdea deletes an idea from a box owned by the caller.
+//
+//	@Summary	Delete an idea
+//	@Tags		ideas
+//	@Security	BearerAuth
+//	@Param		id		path	int	true	"Box ID"
+//	@Param		ideaId	path	int	true	"Idea ID"
+//	@Success	204
+//	@Failure	403	{object}	ErrorResponse
+//	@Failure	404	{object}	ErrorResponse
+//	@Router		/boxes/{id}/ideas/{ideaId} [delete]
+func (h *IdeaHandler) deleteBoxIdea(c *gin.Context) {
+	if err := h.ideas.Delete(c.Param("id"), c.Param("ideaId"), userID(c), isAdmin(c)); err != nil {
+		respondIdeaError(c, err, "idea not found")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, gin.H{})
+}
+
+// attachTags attaches one or more existing tags to an idea.
+//
+//	@Summary	Attach tags to an idea
+//	@Tags		ideas
+//	@Security	BearerAuth
+//	@Param		id		path		int					true	"Box ID"
+//	@Param		ideaId	path		int					true	"Idea ID"
+//	@Param		tags	body		AttachTagsRequest	true	"Tag IDs to attach"
+//	@Success	200		{object}	IdeaResponse
+//	@Failure	400		{object}	ErrorResponse
+//	@Failure	403		{object}	ErrorResponse
+//	@Failure	404		{object}	ErrorResponse
+//	@Router		/boxes/{id}/ideas/{ideaId}/tags [post]
+func (h *IdeaHandler) attachTags(c *gin.Context) {
+	var request AttachTagsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	idea, err := h.ideas.AttachTags(c.Param("id"), c.Param("ideaId"), userID(c), isAdmin(c), request.TagIDs)
+	if err != nil {
+		if errors.Is(err, service.ErrTagNotFound) {
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: "one or more tag IDs do not exist"})
+			return
+		}
+		respondIdeaError(c, err, "idea not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, newIdeaResponse(idea))
+}
+
+// detachTag removes a tag from an idea.
+//
+//	@Summary	Detach a tag from an idea
+//	@Tags		ideas
+//	@Security	BearerAuth
+//	@Param		id		path		int	true	"Box ID"
+//	@Param		ideaId	path		int	true	"Idea ID"
+//	@Param		tagId	path		int	true	"Tag ID"
+//	@Success	200		{object}	IdeaResponse
+//	@Failure	403		{object}	ErrorResponse
+//	@Failure	404		{object}	ErrorResponse
+//	@Router		/boxes/{id}/ideas/{ideaId}/tags/{tagId} [delete]
+func (h *IdeaHandler) detachTag(c *gin.Context) {
+	idea, err := h.ideas.DetachTag(c.Param("id"), c.Param("ideaId"), c.Param("tagId"), userID(c), isAdmin(c))
+	if err != nil {
+		respondIdeaError(c, err, "idea not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, newIdeaResponse(idea))
+}
+
+func respondIdeaError(c *gin.Context, err error, notFoundMessage string) {
+	if errors.Is(err, service.ErrForbidden) {
+		c.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusNotFound, ErrorResponse{Error: notFoundMessage})
+}
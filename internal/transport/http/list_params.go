@@ -0,0 +1,65 @@
+package http
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mitermitek/Idea-Box-API/internal/repository"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 200
+)
+
+// ListResponse is the envelope returned by every paginated list endpoint.
+type ListResponse[T any] struct {
+	Data   []T   `json:"data"`
+	Total  int64 `json:"total"`
+	Limit  int   `json:"limit"`
+	Offset int   `json:"offset"`
+}
+
+// parseListParams reads limit/offset/sort_column/sort_order/q from the
+// query string, defaulting sort_column to defaultSort and rejecting any
+// column not present in allowedSort.
+func parseListParams(c *gin.Context, defaultSort string, allowedSort map[string]bool) (repository.ListParams, error) {
+	limit := defaultLimit
+	if raw := c.Query("limit"); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v <= 0 || v > maxLimit {
+			return repository.ListParams{}, fmt.Errorf("limit must be an integer between 1 and %d", maxLimit)
+		}
+		limit = v
+	}
+
+	offset := 0
+	if raw := c.Query("offset"); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v < 0 {
+			return repository.ListParams{}, fmt.Errorf("offset must be a non-negative integer")
+		}
+		offset = v
+	}
+
+	sortColumn := c.DefaultQuery("sort_column", defaultSort)
+	if !allowedSort[sortColumn] {
+		return repository.ListParams{}, fmt.Errorf("invalid sort_column %q", sortColumn)
+	}
+
+	sortOrder := strings.ToLower(c.DefaultQuery("sort_order", "asc"))
+	if sortOrder != "asc" && sortOrder != "desc" {
+		return repository.ListParams{}, fmt.Errorf("sort_order must be asc or desc")
+	}
+
+	return repository.ListParams{
+		Limit:      limit,
+		Offset:     offset,
+		SortColumn: sortColumn,
+		SortOrder:  sortOrder,
+		Query:      c.Query("q"),
+	}, nil
+}
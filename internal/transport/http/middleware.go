@@ -0,0 +1,62 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mitermitek/Idea-Box-API/internal/domain"
+	"github.com/mitermitek/Idea-Box-API/internal/service"
+)
+
+const (
+	contextKeyUserID = "userID"
+	contextKeyRole   = "role"
+)
+
+// AuthMiddleware parses the Authorization: Bearer <token> header, verifies
+// it against auth, and stores the resolved user ID and role in the
+// request context.
+func AuthMiddleware(auth service.AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		token := strings.TrimPrefix(header, "Bearer ")
+		if token == "" || token == header {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, ErrorResponse{Error: "missing bearer token"})
+			return
+		}
+
+		claims, err := auth.Verify(token)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, ErrorResponse{Error: "invalid or expired token"})
+			return
+		}
+
+		c.Set(contextKeyUserID, claims.UserID)
+		c.Set(contextKeyRole, claims.Role)
+		c.Next()
+	}
+}
+
+// RequireAdmin rejects requests from non-admin users with 403.
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if role, _ := c.Get(contextKeyRole); role != domain.RoleAdmin {
+			c.AbortWithStatusJSON(http.StatusForbidden, ErrorResponse{Error: "admin access required"})
+			return
+		}
+		c.Next()
+	}
+}
+
+func userID(c *gin.Context) uint {
+	id, _ := c.Get(contextKeyUserID)
+	uid, _ := id.(uint)
+	return uid
+}
+
+func isAdmin(c *gin.Context) bool {
+	role, _ := c.Get(contextKeyRole)
+	return role == domain.RoleAdmin
+}
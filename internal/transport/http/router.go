@@ -0,0 +1,55 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+
+	"github.com/mitermitek/Idea-Box-API/docs"
+	"github.com/mitermitek/Idea-Box-API/internal/service"
+)
+
+// RegisterRoutes wires the auth, Box, and Idea routes onto rg. All Box and
+// Idea routes require a valid JWT; /admin/* additionally requires the
+// admin role and operates across every user's boxes.
+func RegisterRoutes(rg *gin.RouterGroup, auth service.AuthService, boxes service.BoxService, ideas service.IdeaService, tags service.TagService) {
+	authHandler := NewAuthHandler(auth)
+	boxHandler := NewBoxHandler(boxes)
+	ideaHandler := NewIdeaHandler(ideas)
+	tagHandler := NewTagHandler(tags)
+
+	rg.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	rg.GET("/openapi.json", func(c *gin.Context) {
+		c.Data(200, "application/json", []byte(docs.SwaggerInfo.ReadDoc()))
+	})
+
+	rg.POST("/auth/register", authHandler.register)
+	rg.POST("/auth/login", authHandler.login)
+
+	protected := rg.Group("/")
+	protected.Use(AuthMiddleware(auth))
+
+	protected.GET("/boxes", boxHandler.getBoxes)
+	protected.GET("/boxes/:id", boxHandler.getBox)
+	protected.POST("/boxes", boxHandler.createBox)
+	protected.PUT("/boxes/:id", boxHandler.updateBox)
+	protected.DELETE("/boxes/:id", boxHandler.deleteBox)
+
+	protected.GET("/boxes/:id/ideas", ideaHandler.getBoxIdeas)
+	protected.GET("/boxes/:id/ideas/:ideaId", ideaHandler.getBoxIdea)
+	protected.POST("/boxes/:id/ideas", ideaHandler.createBoxIdea)
+	protected.PUT("/boxes/:id/ideas/:ideaId", ideaHandler.updateBoxIdea)
+	protected.DELETE("/boxes/:id/ideas/:ideaId", ideaHandler.deleteBoxIdea)
+
+	protected.POST("/boxes/:id/ideas/:ideaId/tags", ideaHandler.attachTags)
+	protected.DELETE("/boxes/:id/ideas/:ideaId/tags/:tagId", ideaHandler.detachTag)
+
+	protected.GET("/tags", tagHandler.getTags)
+	protected.POST("/tags", tagHandler.createTag)
+	protected.PUT("/tags/:id", tagHandler.updateTag)
+	protected.DELETE("/tags/:id", tagHandler.deleteTag)
+
+	admin := rg.Group("/admin")
+	admin.Use(AuthMiddleware(auth), RequireAdmin())
+	admin.GET("/boxes", boxHandler.getAllBoxes)
+}
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mitermitek/Idea-Box-API/internal/service"
+)
+
+type TagHandler struct {
+	tags service.TagService
+}
+
+func NewTagHandler(tags service.TagService) *TagHandler {
+	return &TagHandler{tags: tags}
+}
+
+// getTags lists every tag.
+//
+//	@Summary	List tags
+//	@Tags		tags
+//	@Security	BearerAuth
+//	@Success	200	{array}		TagResponse
+//	@Failure	400	{object}	ErrorResponse
+//	@Router		/tags [get]
+func (h *TagHandler) getTags(c *gin.Context) {
+	tags, err := h.tags.List()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	responses := make([]TagResponse, 0)
+	for _, tag := range tags {
+		responses = append(responses, newTagResponse(&tag))
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// createTag creates a tag, normalizing its slug.
+//
+//	@Summary	Create a tag
+//	@Tags		tags
+//	@Security	BearerAuth
+//	@Param		tag	body		TagRequest	true	"Tag to create"
+//	@Success	201	{object}	TagResponse
+//	@Failure	400	{object}	ErrorResponse
+//	@Router		/tags [post]
+func (h *TagHandler) createTag(c *gin.Context) {
+	var request TagRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	tag, err := h.tags.Create(request.Name, request.Slug)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newTagResponse(tag))
+}
+
+// updateTag updates a tag, renormalizing its slug.
+//
+//	@Summary	Update a tag
+//	@Tags		tags
+//	@Security	BearerAuth
+//	@Param		id	path		int			true	"Tag ID"
+//	@Param		tag	body		TagRequest	true	"Updated tag fields"
+//	@Success	200	{object}	TagResponse
+//	@Failure	400	{object}	ErrorResponse
+//	@Failure	404	{object}	ErrorResponse
+//	@Router		/tags/{id} [put]
+func (h *TagHandler) updateTag(c *gin.Context) {
+	var request TagRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	tag, err := h.tags.Update(c.Param("id"), request.Name, request.Slug)
+	if err != nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "tag not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, newTagResponse(tag))
+}
+
+// deleteTag deletes a tag, detaching it from every idea.
+//
+//	@Summary	Delete a tag
+//	@Tags		tags
+//	@Security	BearerAuth
+//	@Param		id	path	int	true	"Tag ID"
+//	@Success	204
+//	@Failure	404	{object}	ErrorResponse
+//	@Router		/tags/{id} [delete]
+func (h *TagHandler) deleteTag(c *gin.Context) {
+	if err := h.tags.Delete(c.Param("id")); err != nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "tag not found"})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, gin.H{})
+}